@@ -125,6 +125,8 @@ type StreamClaimOptions struct {
 	idleUnixTime int64
 	retryCount   int64
 	isForce      bool
+	isJustId     bool
+	lastId       string
 }
 
 func NewStreamClaimOptions() *StreamClaimOptions {
@@ -149,6 +151,20 @@ func (sco *StreamClaimOptions) SetRetryCount(retryCount int64) *StreamClaimOptio
 	return sco
 }
 
+// If set, the claimed entries are returned as an array of entry IDs only, without their field-value pairs, and
+// the command does not increment the retry count of the claimed entries.
+func (sco *StreamClaimOptions) SetJustId() *StreamClaimOptions {
+	sco.isJustId = true
+	return sco
+}
+
+// Set the last ID of the entry that the consumer has seen, for use by replicas replaying a claim without
+// affecting their own last-delivered state.
+func (sco *StreamClaimOptions) SetLastId(lastId string) *StreamClaimOptions {
+	sco.lastId = lastId
+	return sco
+}
+
 // Valkey API keywords for stream claim options
 const (
 	// ValKey API string to designate IDLE time in milliseconds
@@ -161,8 +177,15 @@ const (
 	FORCE_VALKEY_API string = "FORCE"
 	// ValKey API string to designate JUSTID
 	JUST_ID_VALKEY_API string = "JUSTID"
+	// ValKey API string to designate LASTID
+	LAST_ID_VALKEY_API string = "LASTID"
 )
 
+// IsJustIdSet reports whether `SetJustId` was called, so the command layer can choose the right response parser.
+func (sco *StreamClaimOptions) IsJustIdSet() bool {
+	return sco.isJustId
+}
+
 func (sco *StreamClaimOptions) ToArgs() ([]string, error) {
 	optionArgs := []string{}
 
@@ -182,5 +205,295 @@ func (sco *StreamClaimOptions) ToArgs() ([]string, error) {
 		optionArgs = append(optionArgs, FORCE_VALKEY_API)
 	}
 
+	if sco.isJustId {
+		optionArgs = append(optionArgs, JUST_ID_VALKEY_API)
+	}
+
+	if sco.lastId != "" {
+		optionArgs = append(optionArgs, LAST_ID_VALKEY_API, sco.lastId)
+	}
+
 	return optionArgs, nil
 }
+
+// Optional arguments for `XAutoClaim` in [StreamCommands]
+type XAutoClaimOptions struct {
+	count    int64
+	isJustId bool
+}
+
+func NewXAutoClaimOptions() *XAutoClaimOptions {
+	return &XAutoClaimOptions{}
+}
+
+// Set the number of claimed entries returned per call.
+func (xaco *XAutoClaimOptions) SetCount(count int64) *XAutoClaimOptions {
+	xaco.count = count
+	return xaco
+}
+
+// If set, the claimed entries are returned as an array of entry IDs only, without their field-value pairs, and
+// the command does not increment the retry count of the claimed entries.
+func (xaco *XAutoClaimOptions) SetJustId() *XAutoClaimOptions {
+	xaco.isJustId = true
+	return xaco
+}
+
+// IsJustIdSet reports whether `SetJustId` was called, so the command layer can choose the right response parser.
+func (xaco *XAutoClaimOptions) IsJustIdSet() bool {
+	return xaco.isJustId
+}
+
+// Valkey API keyword to designate COUNT
+const COUNT_VALKEY_API string = "COUNT"
+
+func (xaco *XAutoClaimOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if xaco.count > 0 {
+		args = append(args, COUNT_VALKEY_API, utils.IntToString(xaco.count))
+	}
+	if xaco.isJustId {
+		args = append(args, JUST_ID_VALKEY_API)
+	}
+	return args, nil
+}
+
+// Optional arguments for `XGroupCreate` in [StreamCommands]
+type XGroupCreateOptions struct {
+	makeStream  triStateBool
+	entriesRead int64
+	// isEntriesReadSet distinguishes `SetEntriesRead(0)` from "no ENTRIESREAD argument at all".
+	isEntriesReadSet bool
+}
+
+func NewXGroupCreateOptions() *XGroupCreateOptions {
+	return &XGroupCreateOptions{}
+}
+
+// If set, a new stream will be created if no stream matches the given key.
+func (xgco *XGroupCreateOptions) SetMakeStream() *XGroupCreateOptions {
+	xgco.makeStream = triStateBoolTrue
+	return xgco
+}
+
+// Set the logical "reads" count of the consumer group, used to calculate the `entries-read` field reported by
+// `XINFO GROUPS`.
+func (xgco *XGroupCreateOptions) SetEntriesRead(entriesRead int64) *XGroupCreateOptions {
+	xgco.entriesRead = entriesRead
+	xgco.isEntriesReadSet = true
+	return xgco
+}
+
+// Valkey API keywords for `XGROUP CREATE` options
+const (
+	// ValKey API string to designate MKSTREAM
+	MAKE_STREAM_VALKEY_API string = "MKSTREAM"
+	// ValKey API string to designate ENTRIESREAD
+	ENTRIES_READ_VALKEY_API string = "ENTRIESREAD"
+)
+
+func (xgco *XGroupCreateOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if xgco.makeStream == triStateBoolTrue {
+		args = append(args, MAKE_STREAM_VALKEY_API)
+	}
+	if xgco.isEntriesReadSet {
+		args = append(args, ENTRIES_READ_VALKEY_API, utils.IntToString(xgco.entriesRead))
+	}
+	return args, nil
+}
+
+// Optional arguments for `XGroupSetId` in [StreamCommands]
+type XGroupSetIdOptions struct {
+	entriesRead int64
+	// isEntriesReadSet distinguishes `SetEntriesRead(0)` from "no ENTRIESREAD argument at all".
+	isEntriesReadSet bool
+}
+
+func NewXGroupSetIdOptions() *XGroupSetIdOptions {
+	return &XGroupSetIdOptions{}
+}
+
+// Set the logical "reads" count of the consumer group, used to calculate the `entries-read` field reported by
+// `XINFO GROUPS`.
+func (xgsio *XGroupSetIdOptions) SetEntriesRead(entriesRead int64) *XGroupSetIdOptions {
+	xgsio.entriesRead = entriesRead
+	xgsio.isEntriesReadSet = true
+	return xgsio
+}
+
+func (xgsio *XGroupSetIdOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if xgsio.isEntriesReadSet {
+		args = append(args, ENTRIES_READ_VALKEY_API, utils.IntToString(xgsio.entriesRead))
+	}
+	return args, nil
+}
+
+// Optional arguments for `XInfoStreamFull` in [StreamCommands]
+type XInfoStreamFullOptions struct {
+	count int64
+	// isCountSet distinguishes `SetCount(0)` (explicitly request no limit) from "no COUNT argument at all"
+	// (server falls back to its default limit of 10).
+	isCountSet bool
+}
+
+func NewXInfoStreamFullOptions() *XInfoStreamFullOptions {
+	return &XInfoStreamFullOptions{}
+}
+
+// Limit the number of stream and PEL entries returned. A value of `0` means there is no limit.
+func (xisfo *XInfoStreamFullOptions) SetCount(count int64) *XInfoStreamFullOptions {
+	xisfo.count = count
+	xisfo.isCountSet = true
+	return xisfo
+}
+
+// Valkey API keywords for `XINFO STREAM ... FULL` options
+const (
+	// ValKey API string to designate FULL
+	FULL_VALKEY_API string = "FULL"
+)
+
+func (xisfo *XInfoStreamFullOptions) ToArgs() ([]string, error) {
+	args := []string{FULL_VALKEY_API}
+	if xisfo.isCountSet {
+		args = append(args, COUNT_VALKEY_API, utils.IntToString(xisfo.count))
+	}
+	return args, nil
+}
+
+// Optional arguments for `XPendingWithOptions` in [StreamCommands]
+type XPendingOptions struct {
+	minIdleTime int64
+	start       string
+	end         string
+	count       int64
+	consumer    string
+}
+
+// Create new `XPendingOptions` filtering the detailed form of `XPENDING` to entries between `start` and `end`,
+// returning at most `count` of them.
+func NewXPendingOptions(start string, end string, count int64) *XPendingOptions {
+	return &XPendingOptions{start: start, end: end, count: count}
+}
+
+// Filter the pending entries to those owned by the given consumer.
+func (xpo *XPendingOptions) SetConsumer(consumer string) *XPendingOptions {
+	xpo.consumer = consumer
+	return xpo
+}
+
+// Filter the pending entries to those idle for at least `minIdleTime` milliseconds.
+func (xpo *XPendingOptions) SetMinIdleTime(minIdleTime int64) *XPendingOptions {
+	xpo.minIdleTime = minIdleTime
+	return xpo
+}
+
+func (xpo *XPendingOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if xpo.minIdleTime > 0 {
+		args = append(args, IDLE_VALKEY_API, utils.IntToString(xpo.minIdleTime))
+	}
+
+	args = append(args, xpo.start, xpo.end, utils.IntToString(xpo.count))
+
+	if xpo.consumer != "" {
+		args = append(args, xpo.consumer)
+	}
+
+	return args, nil
+}
+
+// Optional arguments for `XRead` in [StreamCommands]
+type XReadOptions struct {
+	count int64
+	block int64
+	// isBlockSet distinguishes "block forever" (`SetBlock(0)`) from "no BLOCK argument at all".
+	isBlockSet bool
+}
+
+func NewXReadOptions() *XReadOptions {
+	return &XReadOptions{}
+}
+
+// Limit the number of entries returned per stream.
+func (xro *XReadOptions) SetCount(count int64) *XReadOptions {
+	xro.count = count
+	return xro
+}
+
+// Block the command for `block` milliseconds if no entries are available. A value of `0` blocks indefinitely.
+func (xro *XReadOptions) SetBlock(block int64) *XReadOptions {
+	xro.block = block
+	xro.isBlockSet = true
+	return xro
+}
+
+// Valkey API keywords for `XREAD`/`XREADGROUP` options
+const (
+	// ValKey API string to designate GROUP
+	GROUP_VALKEY_API string = "GROUP"
+	// ValKey API string to designate BLOCK
+	BLOCK_VALKEY_API string = "BLOCK"
+	// ValKey API string to designate NOACK
+	NO_ACK_VALKEY_API string = "NOACK"
+	// ValKey API string to designate the STREAMS section of the command
+	STREAMS_VALKEY_API string = "STREAMS"
+)
+
+func (xro *XReadOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if xro.count != 0 {
+		args = append(args, COUNT_VALKEY_API, utils.IntToString(xro.count))
+	}
+	if xro.isBlockSet {
+		args = append(args, BLOCK_VALKEY_API, utils.IntToString(xro.block))
+	}
+	return args, nil
+}
+
+// Optional arguments for `XReadGroup` in [StreamCommands]
+type XReadGroupOptions struct {
+	count      int64
+	block      int64
+	isBlockSet bool
+	noAck      bool
+}
+
+func NewXReadGroupOptions() *XReadGroupOptions {
+	return &XReadGroupOptions{}
+}
+
+// Limit the number of entries returned per stream.
+func (xrgo *XReadGroupOptions) SetCount(count int64) *XReadGroupOptions {
+	xrgo.count = count
+	return xrgo
+}
+
+// Block the command for `block` milliseconds if no entries are available. A value of `0` blocks indefinitely.
+func (xrgo *XReadGroupOptions) SetBlock(block int64) *XReadGroupOptions {
+	xrgo.block = block
+	xrgo.isBlockSet = true
+	return xrgo
+}
+
+// If set, the read entries won't be added to the Pending Entries List (PEL) and won't require acknowledgment.
+func (xrgo *XReadGroupOptions) SetNoAck() *XReadGroupOptions {
+	xrgo.noAck = true
+	return xrgo
+}
+
+func (xrgo *XReadGroupOptions) ToArgs() ([]string, error) {
+	args := []string{}
+	if xrgo.count != 0 {
+		args = append(args, COUNT_VALKEY_API, utils.IntToString(xrgo.count))
+	}
+	if xrgo.isBlockSet {
+		args = append(args, BLOCK_VALKEY_API, utils.IntToString(xrgo.block))
+	}
+	if xrgo.noAck {
+		args = append(args, NO_ACK_VALKEY_API)
+	}
+	return args, nil
+}
@@ -0,0 +1,170 @@
+package options
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestXAutoClaimOptionsToArgs(t *testing.T) {
+	args, err := NewXAutoClaimOptions().SetCount(10).ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"COUNT", "10"}) {
+		t.Fatalf("ToArgs() = %v", args)
+	}
+}
+
+func TestXGroupCreateOptionsToArgs(t *testing.T) {
+	args, err := NewXGroupCreateOptions().SetMakeStream().SetEntriesRead(5).ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"MKSTREAM", "ENTRIESREAD", "5"}) {
+		t.Fatalf("ToArgs() = %v", args)
+	}
+}
+
+func TestXGroupCreateOptionsToArgsEntriesReadZero(t *testing.T) {
+	args, err := NewXGroupCreateOptions().SetEntriesRead(0).ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"ENTRIESREAD", "0"}) {
+		t.Fatalf("ToArgs() = %v, want explicit ENTRIESREAD 0", args)
+	}
+}
+
+func TestXGroupSetIdOptionsToArgsEntriesReadZero(t *testing.T) {
+	args, err := NewXGroupSetIdOptions().SetEntriesRead(0).ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"ENTRIESREAD", "0"}) {
+		t.Fatalf("ToArgs() = %v, want explicit ENTRIESREAD 0", args)
+	}
+}
+
+func TestXInfoStreamFullOptionsToArgs(t *testing.T) {
+	args, err := NewXInfoStreamFullOptions().SetCount(10).ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"FULL", "COUNT", "10"}) {
+		t.Fatalf("ToArgs() = %v", args)
+	}
+}
+
+func TestXInfoStreamFullOptionsToArgsCountZeroMeansNoLimit(t *testing.T) {
+	args, err := NewXInfoStreamFullOptions().SetCount(0).ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"FULL", "COUNT", "0"}) {
+		t.Fatalf("ToArgs() = %v, want explicit COUNT 0", args)
+	}
+}
+
+func TestXReadOptionsToArgs(t *testing.T) {
+	// SetBlock(0) must emit `BLOCK 0` (block forever), distinct from leaving BLOCK unset entirely.
+	args, err := NewXReadOptions().SetCount(10).SetBlock(0).ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"COUNT", "10", "BLOCK", "0"}) {
+		t.Fatalf("ToArgs() = %v", args)
+	}
+
+	args, err = NewXReadOptions().ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{}) {
+		t.Fatalf("ToArgs() = %v, want no BLOCK token when unset", args)
+	}
+}
+
+func TestXReadGroupOptionsToArgs(t *testing.T) {
+	args, err := NewXReadGroupOptions().SetCount(10).SetBlock(100).SetNoAck().ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(args, []string{"COUNT", "10", "BLOCK", "100", "NOACK"}) {
+		t.Fatalf("ToArgs() = %v", args)
+	}
+}
+
+func TestStreamClaimOptionsToArgsOrdering(t *testing.T) {
+	args, err := NewStreamClaimOptions().
+		SetIdleTime(1).
+		SetIdleUnixTime(2).
+		SetRetryCount(3).
+		SetJustId().
+		SetLastId("0-1").
+		ToArgs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"IDLE", "1", "TIME", "2", "RETRYCOUNT", "3", "JUSTID", "LASTID", "0-1"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("ToArgs() = %v, want %v", args, expected)
+	}
+}
+
+// TestStreamClaimOptionsCopyLeavesOriginalUnset mirrors the copy-before-SetJustId() pattern that
+// XClaimJustIdWithOptions/XAutoClaimJustIdWithOptions use to avoid mutating the caller's options: since
+// StreamClaimOptions holds only value fields, a shallow struct copy is a full copy, so setting JustId on the
+// copy must not affect the original.
+func TestStreamClaimOptionsCopyLeavesOriginalUnset(t *testing.T) {
+	claimOptions := NewStreamClaimOptions()
+	justIdOptions := *claimOptions
+	justIdOptions.SetJustId()
+
+	if claimOptions.IsJustIdSet() {
+		t.Fatalf("copying before SetJustId() should not affect the original options")
+	}
+	if !justIdOptions.IsJustIdSet() {
+		t.Fatalf("expected the copy to have JustId set")
+	}
+}
+
+func TestXPendingOptionsToArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *XPendingOptions
+		expected []string
+	}{
+		{
+			name:     "no idle, no consumer",
+			options:  NewXPendingOptions("-", "+", 10),
+			expected: []string{"-", "+", "10"},
+		},
+		{
+			name:     "with min idle time",
+			options:  NewXPendingOptions("-", "+", 10).SetMinIdleTime(3600000),
+			expected: []string{"IDLE", "3600000", "-", "+", "10"},
+		},
+		{
+			name:     "with min idle time and consumer",
+			options:  NewXPendingOptions("-", "+", 10).SetMinIdleTime(3600000).SetConsumer("myConsumer"),
+			expected: []string{"IDLE", "3600000", "-", "+", "10", "myConsumer"},
+		},
+		{
+			name:     "with consumer only",
+			options:  NewXPendingOptions("-", "+", 10).SetConsumer("myConsumer"),
+			expected: []string{"-", "+", "10", "myConsumer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := tt.options.ToArgs()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(args, tt.expected) {
+				t.Fatalf("ToArgs() = %v, want %v", args, tt.expected)
+			}
+		})
+	}
+}
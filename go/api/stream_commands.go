@@ -78,6 +78,9 @@ type StreamCommands interface {
 
 	// Changes the ownership of a pending message.
 	//
+	// Note: `options.SetJustId()` has no effect on this method -- the response is always parsed as the full-entries
+	// map. Use [StreamCommands.XClaimWithOptionsResult] if `options` may request `JUSTID`.
+	//
 	// See [valkey.io] for details.
 	//
 	// Parameters:
@@ -105,9 +108,42 @@ type StreamCommands interface {
 		options *options.StreamClaimOptions,
 	) (map[Result[string]][][]Result[string], error)
 
+	// Changes the ownership of a pending message. Unlike [XClaimWithOptions], this variant supports `JUSTID`, so the
+	// response is wrapped in an [XClaimResult] to represent either shape.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key      - The key of the stream.
+	//  group    - The name of the consumer group.
+	//  consumer - The name of the consumer.
+	//  minIdleTime - The minimum idle time in milliseconds.
+	//  ids        - The ids of the entries to claim.
+	//  options    - Stream claim options.
+	//
+	// Return value:
+	//  An [XClaimResult] holding the claimed entries, or just their ids if `options.SetJustId()` was used.
+	//
+	// Example:
+	//  opts := options.NewStreamClaimOptions().SetJustId()
+	//  result, err := client.XClaimWithOptionsResult("myStream", "myGroup", "myConsumer", 3600000, []string{"0-1"}, opts)
+	//  result.IsJustId(): true
+	//
+	// [valkey.io]: https://valkey.io/commands/xclaim/
+	XClaimWithOptionsResult(
+		key string,
+		group string,
+		consumer string,
+		minIdleTime int64,
+		ids []string,
+		options *options.StreamClaimOptions,
+	) (XClaimResult, error)
+
 	// Changes the ownership of a pending message. This function returns an `array` with
 	// only the message/entry IDs, and is equivalent to using `JUSTID` in the Valkey API.
 	//
+	// Deprecated: use [StreamCommands.XClaimWithOptionsResult] with `options.NewStreamClaimOptions().SetJustId()` instead.
+	//
 	// See [valkey.io] for details.
 	//
 	// Parameters:
@@ -129,6 +165,8 @@ type StreamCommands interface {
 	// Changes the ownership of a pending message. This function returns an `array` with
 	// only the message/entry IDs, and is equivalent to using `JUSTID` in the Valkey API.
 	//
+	// Deprecated: use [StreamCommands.XClaimWithOptionsResult] with `options.SetJustId()` instead.
+	//
 	// See [valkey.io] for details.
 	//
 	// Parameters:
@@ -154,4 +192,505 @@ type StreamCommands interface {
 		ids []string,
 		options *options.StreamClaimOptions,
 	) ([]Result[string], error)
+
+	// Transfers ownership of pending stream entries that match the specified criteria. This command uses a cursor,
+	// returned in the response, to allow it to be used to iterate over the same consumer group's Pending Entries List
+	// (PEL) in multiple calls, without losing entries that were added after the last call finished.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key         - The key of the stream.
+	//  group       - The name of the consumer group.
+	//  consumer    - The name of the consumer.
+	//  minIdleTime - The minimum idle time in milliseconds.
+	//  start       - Filters the claimed entries to those that have an ID equal or greater than the given value.
+	//
+	// Return value:
+	//  A cursor-style triple containing:
+	//    - The next start ID to use in the next call, or "0-0" if the entire PEL has been scanned.
+	//    - A `map` of the claimed entries, with the same format as [XClaim].
+	//    - An array of message IDs that were in the Pending Entries List but no longer exist in the stream and
+	//      were therefore removed from the PEL without being claimed.
+	//
+	// Example:
+	//  nextCursor, entries, deletedIds, err := client.XAutoClaim("myStream", "myGroup", "myConsumer", 3600000, "0-0")
+	//
+	// [valkey.io]: https://valkey.io/commands/xautoclaim/
+	XAutoClaim(
+		key string,
+		group string,
+		consumer string,
+		minIdleTime int64,
+		start string,
+	) (Result[string], map[Result[string]][][]Result[string], []Result[string], error)
+
+	// Transfers ownership of pending stream entries that match the specified criteria. Unlike [XAutoClaim], this
+	// variant accepts options that may request `JUSTID`, so the claimed entries are wrapped in an [XAutoClaimResult]
+	// to represent either shape.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key         - The key of the stream.
+	//  group       - The name of the consumer group.
+	//  consumer    - The name of the consumer.
+	//  minIdleTime - The minimum idle time in milliseconds.
+	//  start       - Filters the claimed entries to those that have an ID equal or greater than the given value.
+	//  options     - Stream auto-claim options.
+	//
+	// Return value:
+	//  A cursor-style triple containing:
+	//    - The next start ID to use in the next call, or "0-0" if the entire PEL has been scanned.
+	//    - An [XAutoClaimResult] holding the claimed entries, or just their ids if `options.SetJustId()` was used.
+	//    - An array of message IDs that were in the Pending Entries List but no longer exist in the stream and
+	//      were therefore removed from the PEL without being claimed.
+	//
+	// Example:
+	//  opts := options.NewXAutoClaimOptions().SetCount(10)
+	//  nextCursor, result, deletedIds, err := client.XAutoClaimWithOptions("myStream", "myGroup", "myConsumer", 3600000, "0-0", opts)
+	//  result.IsJustId(): false
+	//
+	// [valkey.io]: https://valkey.io/commands/xautoclaim/
+	XAutoClaimWithOptions(
+		key string,
+		group string,
+		consumer string,
+		minIdleTime int64,
+		start string,
+		options *options.XAutoClaimOptions,
+	) (Result[string], XAutoClaimResult, []Result[string], error)
+
+	// Transfers ownership of pending stream entries that match the specified criteria. This function returns an
+	// `array` with only the message/entry IDs for the claimed entries, and is equivalent to using `JUSTID` in the
+	// Valkey API.
+	//
+	// Deprecated: use [StreamCommands.XAutoClaimWithOptions] with `options.NewXAutoClaimOptions().SetJustId()` instead.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key         - The key of the stream.
+	//  group       - The name of the consumer group.
+	//  consumer    - The name of the consumer.
+	//  minIdleTime - The minimum idle time in milliseconds.
+	//  start       - Filters the claimed entries to those that have an ID equal or greater than the given value.
+	//
+	// Return value:
+	//  A cursor-style triple containing:
+	//    - The next start ID to use in the next call, or "0-0" if the entire PEL has been scanned.
+	//    - An array of the IDs of the entries that were claimed by the consumer.
+	//    - An array of message IDs that were removed from the PEL because they no longer exist in the stream.
+	//
+	// Example:
+	//  nextCursor, claimedIds, deletedIds, err := client.XAutoClaimJustId("myStream", "myGroup", "myConsumer", 3600000, "0-0")
+	//
+	// [valkey.io]: https://valkey.io/commands/xautoclaim/
+	XAutoClaimJustId(
+		key string,
+		group string,
+		consumer string,
+		minIdleTime int64,
+		start string,
+	) (Result[string], []Result[string], []Result[string], error)
+
+	// Transfers ownership of pending stream entries that match the specified criteria. This function returns an
+	// `array` with only the message/entry IDs for the claimed entries, and is equivalent to using `JUSTID` in the
+	// Valkey API.
+	//
+	// Deprecated: use [StreamCommands.XAutoClaimWithOptions] with `options.SetJustId()` instead.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key         - The key of the stream.
+	//  group       - The name of the consumer group.
+	//  consumer    - The name of the consumer.
+	//  minIdleTime - The minimum idle time in milliseconds.
+	//  start       - Filters the claimed entries to those that have an ID equal or greater than the given value.
+	//  options     - Stream auto-claim options.
+	//
+	// Return value:
+	//  A cursor-style triple with the same format as [XAutoClaimJustId].
+	//
+	// Example:
+	//  opts := options.NewXAutoClaimOptions().SetCount(10)
+	//  nextCursor, claimedIds, deletedIds, err := client.XAutoClaimJustIdWithOptions(
+	//      "myStream", "myGroup", "myConsumer", 3600000, "0-0", opts)
+	//
+	// [valkey.io]: https://valkey.io/commands/xautoclaim/
+	XAutoClaimJustIdWithOptions(
+		key string,
+		group string,
+		consumer string,
+		minIdleTime int64,
+		start string,
+		options *options.XAutoClaimOptions,
+	) (Result[string], []Result[string], []Result[string], error)
+
+	// Creates a new consumer group for the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key   - The key of the stream.
+	//  group - The newly created consumer group name.
+	//  id    - The stream entry ID that, if not the special `$` ID, specifies the last delivered entry in the
+	//          stream the consumer group should use as its starting point.
+	//
+	// Return value:
+	//  `"OK"`.
+	//
+	// Example:
+	//  result, err := client.XGroupCreate("myStream", "myGroup", "0-0")
+	//  result.Value(): "OK"
+	//
+	// [valkey.io]: https://valkey.io/commands/xgroup-create/
+	XGroupCreate(key string, group string, id string) (Result[string], error)
+
+	// Creates a new consumer group for the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key     - The key of the stream.
+	//  group   - The newly created consumer group name.
+	//  id      - The stream entry ID that, if not the special `$` ID, specifies the last delivered entry in the
+	//            stream the consumer group should use as its starting point.
+	//  options - The group creation options.
+	//
+	// Return value:
+	//  `"OK"`.
+	//
+	// Example:
+	//  opts := options.NewXGroupCreateOptions().SetMakeStream()
+	//  result, err := client.XGroupCreateWithOptions("myStream", "myGroup", "$", opts)
+	//  result.Value(): "OK"
+	//
+	// [valkey.io]: https://valkey.io/commands/xgroup-create/
+	XGroupCreateWithOptions(
+		key string,
+		group string,
+		id string,
+		options *options.XGroupCreateOptions,
+	) (Result[string], error)
+
+	// Destroys the consumer group `group` for the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key   - The key of the stream.
+	//  group - The consumer group name to delete.
+	//
+	// Return value:
+	//  `true` if the consumer group was destroyed, `false` if the group didn't exist.
+	//
+	// Example:
+	//  result, err := client.XGroupDestroy("myStream", "myGroup")
+	//  result: true
+	//
+	// [valkey.io]: https://valkey.io/commands/xgroup-destroy/
+	XGroupDestroy(key string, group string) (bool, error)
+
+	// Creates a consumer named `consumer` in the consumer group `group` for the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key      - The key of the stream.
+	//  group    - The consumer group name.
+	//  consumer - The newly created consumer.
+	//
+	// Return value:
+	//  `true` if the consumer was created, `false` if the consumer already existed.
+	//
+	// Example:
+	//  result, err := client.XGroupCreateConsumer("myStream", "myGroup", "myConsumer")
+	//  result: true
+	//
+	// [valkey.io]: https://valkey.io/commands/xgroup-createconsumer/
+	XGroupCreateConsumer(key string, group string, consumer string) (bool, error)
+
+	// Deletes a consumer named `consumer` from the consumer group `group` for the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key      - The key of the stream.
+	//  group    - The consumer group name.
+	//  consumer - The consumer to delete.
+	//
+	// Return value:
+	//  The number of pending messages the consumer had before it was deleted.
+	//
+	// Example:
+	//  result, err := client.XGroupDelConsumer("myStream", "myGroup", "myConsumer")
+	//  result: 0
+	//
+	// [valkey.io]: https://valkey.io/commands/xgroup-delconsumer/
+	XGroupDelConsumer(key string, group string, consumer string) (int64, error)
+
+	// Sets the last delivered ID for a consumer group.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key   - The key of the stream.
+	//  group - The consumer group name.
+	//  id    - The stream entry ID that, if not the special `$` ID, should be set as the last delivered ID for the
+	//          consumer group.
+	//
+	// Return value:
+	//  `"OK"`.
+	//
+	// Example:
+	//  result, err := client.XGroupSetId("myStream", "myGroup", "0-0")
+	//  result.Value(): "OK"
+	//
+	// [valkey.io]: https://valkey.io/commands/xgroup-setid/
+	XGroupSetId(key string, group string, id string) (Result[string], error)
+
+	// Sets the last delivered ID for a consumer group.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key     - The key of the stream.
+	//  group   - The consumer group name.
+	//  id      - The stream entry ID that, if not the special `$` ID, should be set as the last delivered ID for the
+	//            consumer group.
+	//  options - The group set id options.
+	//
+	// Return value:
+	//  `"OK"`.
+	//
+	// Example:
+	//  opts := options.NewXGroupSetIdOptions().SetEntriesRead(10)
+	//  result, err := client.XGroupSetIdWithOptions("myStream", "myGroup", "0-0", opts)
+	//  result.Value(): "OK"
+	//
+	// [valkey.io]: https://valkey.io/commands/xgroup-setid/
+	XGroupSetIdWithOptions(
+		key string,
+		group string,
+		id string,
+		options *options.XGroupSetIdOptions,
+	) (Result[string], error)
+
+	// Returns information about the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key - The key of the stream.
+	//
+	// Return value:
+	//  A [StreamInfo] with general information about the stream at `key`.
+	//
+	// Example:
+	//  result, err := client.XInfoStream("myStream")
+	//  result.Length: 2
+	//
+	// [valkey.io]: https://valkey.io/commands/xinfo-stream/
+	XInfoStream(key string) (StreamInfo, error)
+
+	// Returns verbose information about the stream stored at `key`, including nested entries, groups, and
+	// consumer state.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key - The key of the stream.
+	//
+	// Return value:
+	//  A [StreamFullInfo] with verbose information about the stream at `key`.
+	//
+	// Example:
+	//  result, err := client.XInfoStreamFull("myStream")
+	//  len(result.Entries): 2
+	//
+	// [valkey.io]: https://valkey.io/commands/xinfo-stream/
+	XInfoStreamFull(key string) (StreamFullInfo, error)
+
+	// Returns verbose information about the stream stored at `key`, including nested entries, groups, and
+	// consumer state.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key     - The key of the stream.
+	//  options - The `FULL` options, including an optional limit on the number of entries reported.
+	//
+	// Return value:
+	//  A [StreamFullInfo] with verbose information about the stream at `key`.
+	//
+	// Example:
+	//  opts := options.NewXInfoStreamFullOptions().SetCount(10)
+	//  result, err := client.XInfoStreamFullWithOptions("myStream", opts)
+	//  len(result.Entries): 10
+	//
+	// [valkey.io]: https://valkey.io/commands/xinfo-stream/
+	XInfoStreamFullWithOptions(key string, options *options.XInfoStreamFullOptions) (StreamFullInfo, error)
+
+	// Returns the list of all consumer groups of the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key - The key of the stream.
+	//
+	// Return value:
+	//  An array of [StreamGroupInfo], one per consumer group of the stream at `key`.
+	//
+	// Example:
+	//  result, err := client.XInfoGroups("myStream")
+	//  result[0].Name: "myGroup"
+	//
+	// [valkey.io]: https://valkey.io/commands/xinfo-groups/
+	XInfoGroups(key string) ([]StreamGroupInfo, error)
+
+	// Returns the list of consumers of the consumer group `group` for the stream stored at `key`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key   - The key of the stream.
+	//  group - The consumer group name.
+	//
+	// Return value:
+	//  An array of [StreamConsumerInfo], one per consumer of `group`.
+	//
+	// Example:
+	//  result, err := client.XInfoConsumers("myStream", "myGroup")
+	//  result[0].Name: "myConsumer"
+	//
+	// [valkey.io]: https://valkey.io/commands/xinfo-consumers/
+	XInfoConsumers(key string, group string) ([]StreamConsumerInfo, error)
+
+	// Returns the number of messages that were delivered to a consumer group but not yet acknowledged, along with
+	// the ID range they fall in and a per-consumer breakdown.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key   - The key of the stream.
+	//  group - The consumer group name.
+	//
+	// Return value:
+	//  A [StreamPendingSummary].
+	//
+	// Example:
+	//  result, err := client.XPending("myStream", "myGroup")
+	//  result.NumOfMessages: 2
+	//
+	// [valkey.io]: https://valkey.io/commands/xpending/
+	XPending(key string, group string) (StreamPendingSummary, error)
+
+	// Returns the detailed list of pending messages for a consumer group, filtered and bounded by `options`.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  key     - The key of the stream.
+	//  group   - The consumer group name.
+	//  options - The filtering options.
+	//
+	// Return value:
+	//  An array of [StreamPendingDetail].
+	//
+	// Example:
+	//  opts := options.NewXPendingOptions("-", "+", 10).SetMinIdleTime(3600000)
+	//  result, err := client.XPendingWithOptions("myStream", "myGroup", opts)
+	//  result[0].Id: "1526569498055-0"
+	//
+	// [valkey.io]: https://valkey.io/commands/xpending/
+	XPendingWithOptions(key string, group string, options *options.XPendingOptions) ([]StreamPendingDetail, error)
+
+	// Reads entries from the given streams.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  streams - A `map` of stream keys to the ID after which new entries should be read, with the special ID `$`
+	//            meaning "only new entries from now on".
+	//
+	// Return value:
+	//  A `map` of stream keys to the array of [StreamEntry] read from each stream. Streams with no new entries are
+	//  omitted from the response.
+	//
+	// Example:
+	//  result, err := client.XRead(map[string]string{"myStream": "0-0"})
+	//  result["myStream"][0].ID: "1526919030474-55"
+	//
+	// [valkey.io]: https://valkey.io/commands/xread/
+	XRead(streams map[string]string) (map[string][]StreamEntry, error)
+
+	// Reads entries from the given streams.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  streams - A `map` of stream keys to the ID after which new entries should be read, with the special ID `$`
+	//            meaning "only new entries from now on".
+	//  options - The read options, including an optional count limit and blocking behavior.
+	//
+	// Return value:
+	//  A `map` of stream keys to the array of [StreamEntry] read from each stream. Streams with no new entries are
+	//  omitted from the response.
+	//
+	// Example:
+	//  opts := options.NewXReadOptions().SetCount(10).SetBlock(0)
+	//  result, err := client.XReadWithOptions(map[string]string{"myStream": "$"}, opts)
+	//
+	// [valkey.io]: https://valkey.io/commands/xread/
+	XReadWithOptions(streams map[string]string, options *options.XReadOptions) (map[string][]StreamEntry, error)
+
+	// Reads entries from the given streams on behalf of a consumer group.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  group    - The consumer group name.
+	//  consumer - The consumer name.
+	//  streams  - A `map` of stream keys to the ID after which new entries should be read, with the special ID `>`
+	//             meaning "entries never delivered to any other consumer".
+	//
+	// Return value:
+	//  A `map` of stream keys to the array of [StreamEntry] read from each stream. Streams with no new entries are
+	//  omitted from the response.
+	//
+	// Example:
+	//  result, err := client.XReadGroup("myGroup", "myConsumer", map[string]string{"myStream": ">"})
+	//  result["myStream"][0].ID: "1526919030474-55"
+	//
+	// [valkey.io]: https://valkey.io/commands/xreadgroup/
+	XReadGroup(group string, consumer string, streams map[string]string) (map[string][]StreamEntry, error)
+
+	// Reads entries from the given streams on behalf of a consumer group.
+	//
+	// See [valkey.io] for details.
+	//
+	// Parameters:
+	//  group    - The consumer group name.
+	//  consumer - The consumer name.
+	//  streams  - A `map` of stream keys to the ID after which new entries should be read, with the special ID `>`
+	//             meaning "entries never delivered to any other consumer".
+	//  options  - The read options, including an optional count limit, blocking behavior, and `NOACK`.
+	//
+	// Return value:
+	//  A `map` of stream keys to the array of [StreamEntry] read from each stream. Streams with no new entries are
+	//  omitted from the response.
+	//
+	// Example:
+	//  opts := options.NewXReadGroupOptions().SetCount(10).SetNoAck()
+	//  result, err := client.XReadGroupWithOptions("myGroup", "myConsumer", map[string]string{"myStream": ">"}, opts)
+	//
+	// [valkey.io]: https://valkey.io/commands/xreadgroup/
+	XReadGroupWithOptions(
+		group string,
+		consumer string,
+		streams map[string]string,
+		options *options.XReadGroupOptions,
+	) (map[string][]StreamEntry, error)
 }
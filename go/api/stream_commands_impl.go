@@ -0,0 +1,446 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/valkey-io/valkey-glide/go/glide/api/options"
+	"github.com/valkey-io/valkey-glide/go/glide/utils"
+)
+
+// Request types routed to the FFI core for the stream commands implemented in this file.
+const (
+	requestTypeXClaim               = "XClaim"
+	requestTypeXAutoClaim           = "XAutoClaim"
+	requestTypeXGroupCreate         = "XGroupCreate"
+	requestTypeXGroupDestroy        = "XGroupDestroy"
+	requestTypeXGroupCreateConsumer = "XGroupCreateConsumer"
+	requestTypeXGroupDelConsumer    = "XGroupDelConsumer"
+	requestTypeXGroupSetId          = "XGroupSetId"
+	requestTypeXInfoStream          = "XInfoStream"
+	requestTypeXInfoGroups          = "XInfoGroups"
+	requestTypeXInfoConsumers       = "XInfoConsumers"
+	requestTypeXPending             = "XPending"
+	requestTypeXRead                = "XRead"
+	requestTypeXReadGroup           = "XReadGroup"
+)
+
+func (client *baseClient) XAutoClaim(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (Result[string], map[Result[string]][][]Result[string], []Result[string], error) {
+	nextCursor, result, deleted, err := client.XAutoClaimWithOptions(
+		key, group, consumer, minIdleTime, start, options.NewXAutoClaimOptions(),
+	)
+	if err != nil {
+		return CreateNilStringResult(), nil, nil, err
+	}
+	return nextCursor, result.Entries(), deleted, nil
+}
+
+func (client *baseClient) XAutoClaimWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	autoClaimOptions *options.XAutoClaimOptions,
+) (Result[string], XAutoClaimResult, []Result[string], error) {
+	args := []string{key, group, consumer, utils.IntToString(minIdleTime), start}
+	optionArgs, err := autoClaimOptions.ToArgs()
+	if err != nil {
+		return CreateNilStringResult(), XAutoClaimResult{}, nil, err
+	}
+	args = append(args, optionArgs...)
+
+	response, err := client.executeCommand(requestTypeXAutoClaim, args)
+	if err != nil {
+		return CreateNilStringResult(), XAutoClaimResult{}, nil, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return CreateNilStringResult(), XAutoClaimResult{}, nil, err
+	}
+
+	nextCursor, claimed, deleted := parseXAutoClaimResponse(raw)
+	if autoClaimOptions.IsJustIdSet() {
+		return nextCursor, NewXAutoClaimJustIdResult(parseStringResultArray(claimed)), deleted, nil
+	}
+	return nextCursor, NewXAutoClaimEntriesResult(parseClaimedEntries(claimed)), deleted, nil
+}
+
+func (client *baseClient) XAutoClaimJustId(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+) (Result[string], []Result[string], []Result[string], error) {
+	return client.XAutoClaimJustIdWithOptions(key, group, consumer, minIdleTime, start, options.NewXAutoClaimOptions())
+}
+
+func (client *baseClient) XAutoClaimJustIdWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	start string,
+	autoClaimOptions *options.XAutoClaimOptions,
+) (Result[string], []Result[string], []Result[string], error) {
+	// Copy rather than mutate the caller-supplied options: SetJustId() sets isJustId in place, and the caller
+	// may reuse autoClaimOptions for an unrelated, non-JUSTID XAutoClaimWithOptions call afterwards.
+	justIdOptions := *autoClaimOptions
+	nextCursor, result, deleted, err := client.XAutoClaimWithOptions(
+		key, group, consumer, minIdleTime, start, justIdOptions.SetJustId(),
+	)
+	if err != nil {
+		return CreateNilStringResult(), nil, nil, err
+	}
+	return nextCursor, result.Ids(), deleted, nil
+}
+
+func (client *baseClient) XGroupCreate(key string, group string, id string) (Result[string], error) {
+	return client.XGroupCreateWithOptions(key, group, id, options.NewXGroupCreateOptions())
+}
+
+func (client *baseClient) XGroupCreateWithOptions(
+	key string,
+	group string,
+	id string,
+	createOptions *options.XGroupCreateOptions,
+) (Result[string], error) {
+	args := []string{key, group, id}
+	optionArgs, err := createOptions.ToArgs()
+	if err != nil {
+		return CreateNilStringResult(), err
+	}
+	args = append(args, optionArgs...)
+
+	response, err := client.executeCommand(requestTypeXGroupCreate, args)
+	if err != nil {
+		return CreateNilStringResult(), err
+	}
+	return handleStringResponse(response)
+}
+
+func (client *baseClient) XGroupDestroy(key string, group string) (bool, error) {
+	response, err := client.executeCommand(requestTypeXGroupDestroy, []string{key, group})
+	if err != nil {
+		return false, err
+	}
+	return handleBoolResponse(response)
+}
+
+func (client *baseClient) XGroupCreateConsumer(key string, group string, consumer string) (bool, error) {
+	response, err := client.executeCommand(requestTypeXGroupCreateConsumer, []string{key, group, consumer})
+	if err != nil {
+		return false, err
+	}
+	return handleBoolResponse(response)
+}
+
+func (client *baseClient) XGroupDelConsumer(key string, group string, consumer string) (int64, error) {
+	response, err := client.executeCommand(requestTypeXGroupDelConsumer, []string{key, group, consumer})
+	if err != nil {
+		return 0, err
+	}
+	return handleIntResponse(response)
+}
+
+func (client *baseClient) XGroupSetId(key string, group string, id string) (Result[string], error) {
+	return client.XGroupSetIdWithOptions(key, group, id, options.NewXGroupSetIdOptions())
+}
+
+func (client *baseClient) XGroupSetIdWithOptions(
+	key string,
+	group string,
+	id string,
+	setIdOptions *options.XGroupSetIdOptions,
+) (Result[string], error) {
+	args := []string{key, group, id}
+	optionArgs, err := setIdOptions.ToArgs()
+	if err != nil {
+		return CreateNilStringResult(), err
+	}
+	args = append(args, optionArgs...)
+
+	response, err := client.executeCommand(requestTypeXGroupSetId, args)
+	if err != nil {
+		return CreateNilStringResult(), err
+	}
+	return handleStringResponse(response)
+}
+
+func (client *baseClient) XInfoStream(key string) (StreamInfo, error) {
+	response, err := client.executeCommand(requestTypeXInfoStream, []string{key})
+	if err != nil {
+		return StreamInfo{}, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return StreamInfo{}, err
+	}
+	return parseStreamInfo(asStringMap(raw)), nil
+}
+
+func (client *baseClient) XInfoStreamFull(key string) (StreamFullInfo, error) {
+	return client.XInfoStreamFullWithOptions(key, options.NewXInfoStreamFullOptions())
+}
+
+func (client *baseClient) XInfoStreamFullWithOptions(
+	key string,
+	fullOptions *options.XInfoStreamFullOptions,
+) (StreamFullInfo, error) {
+	args, err := fullOptions.ToArgs()
+	if err != nil {
+		return StreamFullInfo{}, err
+	}
+	args = append([]string{key}, args...)
+
+	response, err := client.executeCommand(requestTypeXInfoStream, args)
+	if err != nil {
+		return StreamFullInfo{}, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return StreamFullInfo{}, err
+	}
+	return parseStreamFullInfo(asStringMap(raw)), nil
+}
+
+func (client *baseClient) XInfoGroups(key string) ([]StreamGroupInfo, error) {
+	response, err := client.executeCommand(requestTypeXInfoGroups, []string{key})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseStreamGroupInfos(asAnyArray(raw)), nil
+}
+
+func (client *baseClient) XInfoConsumers(key string, group string) ([]StreamConsumerInfo, error) {
+	response, err := client.executeCommand(requestTypeXInfoConsumers, []string{key, group})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseStreamConsumerInfos(asAnyArray(raw)), nil
+}
+
+func (client *baseClient) XPending(key string, group string) (StreamPendingSummary, error) {
+	response, err := client.executeCommand(requestTypeXPending, []string{key, group})
+	if err != nil {
+		return StreamPendingSummary{}, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return StreamPendingSummary{}, err
+	}
+	return parseStreamPendingSummary(asAnyArray(raw)), nil
+}
+
+func (client *baseClient) XPendingWithOptions(
+	key string,
+	group string,
+	pendingOptions *options.XPendingOptions,
+) ([]StreamPendingDetail, error) {
+	optionArgs, err := pendingOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args := append([]string{key, group}, optionArgs...)
+
+	response, err := client.executeCommand(requestTypeXPending, args)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseStreamPendingDetails(asAnyArray(raw)), nil
+}
+
+// buildStreamsArgs builds the trailing `STREAMS key... id...` section shared by `XREAD`/`XREADGROUP`.
+func buildStreamsArgs(streams map[string]string) []string {
+	args := make([]string, 0, 2*len(streams)+1)
+	args = append(args, options.STREAMS_VALKEY_API)
+	keys := make([]string, 0, len(streams))
+	ids := make([]string, 0, len(streams))
+	for key, id := range streams {
+		keys = append(keys, key)
+		ids = append(ids, id)
+	}
+	args = append(args, keys...)
+	args = append(args, ids...)
+	return args
+}
+
+func (client *baseClient) XRead(streams map[string]string) (map[string][]StreamEntry, error) {
+	return client.XReadWithOptions(streams, options.NewXReadOptions())
+}
+
+func (client *baseClient) XReadWithOptions(
+	streams map[string]string,
+	readOptions *options.XReadOptions,
+) (map[string][]StreamEntry, error) {
+	optionArgs, err := readOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args := append(optionArgs, buildStreamsArgs(streams)...)
+
+	response, err := client.executeCommand(requestTypeXRead, args)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseStreamReadResponse(raw), nil
+}
+
+func (client *baseClient) XReadGroup(
+	group string,
+	consumer string,
+	streams map[string]string,
+) (map[string][]StreamEntry, error) {
+	return client.XReadGroupWithOptions(group, consumer, streams, options.NewXReadGroupOptions())
+}
+
+func (client *baseClient) XReadGroupWithOptions(
+	group string,
+	consumer string,
+	streams map[string]string,
+	readGroupOptions *options.XReadGroupOptions,
+) (map[string][]StreamEntry, error) {
+	args := []string{options.GROUP_VALKEY_API, group, consumer}
+	optionArgs, err := readGroupOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, optionArgs...)
+	args = append(args, buildStreamsArgs(streams)...)
+
+	response, err := client.executeCommand(requestTypeXReadGroup, args)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseStreamReadResponse(raw), nil
+}
+
+func (client *baseClient) XClaim(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) (map[Result[string]][][]Result[string], error) {
+	return client.XClaimWithOptions(key, group, consumer, minIdleTime, ids, options.NewStreamClaimOptions())
+}
+
+func (client *baseClient) XClaimWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	claimOptions *options.StreamClaimOptions,
+) (map[Result[string]][][]Result[string], error) {
+	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime)}, ids...)
+	optionArgs, err := claimOptions.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	if claimOptions.IsJustIdSet() {
+		// JUSTID is dropped: this method always parses the reply as the full-entries map.
+		filtered := make([]string, 0, len(optionArgs))
+		for _, arg := range optionArgs {
+			if arg != options.JUST_ID_VALKEY_API {
+				filtered = append(filtered, arg)
+			}
+		}
+		optionArgs = filtered
+	}
+	args = append(args, optionArgs...)
+
+	response, err := client.executeCommand(requestTypeXClaim, args)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return parseClaimedEntries(raw), nil
+}
+
+func (client *baseClient) XClaimWithOptionsResult(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	claimOptions *options.StreamClaimOptions,
+) (XClaimResult, error) {
+	args := append([]string{key, group, consumer, utils.IntToString(minIdleTime)}, ids...)
+	optionArgs, err := claimOptions.ToArgs()
+	if err != nil {
+		return XClaimResult{}, err
+	}
+	args = append(args, optionArgs...)
+
+	response, err := client.executeCommand(requestTypeXClaim, args)
+	if err != nil {
+		return XClaimResult{}, err
+	}
+	raw, err := handleAnyResponse(response)
+	if err != nil {
+		return XClaimResult{}, err
+	}
+
+	if claimOptions.IsJustIdSet() {
+		return NewXClaimJustIdResult(parseStringResultArray(raw)), nil
+	}
+	return NewXClaimEntriesResult(parseClaimedEntries(raw)), nil
+}
+
+func (client *baseClient) XClaimJustId(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+) ([]Result[string], error) {
+	return client.XClaimJustIdWithOptions(key, group, consumer, minIdleTime, ids, options.NewStreamClaimOptions())
+}
+
+func (client *baseClient) XClaimJustIdWithOptions(
+	key string,
+	group string,
+	consumer string,
+	minIdleTime int64,
+	ids []string,
+	claimOptions *options.StreamClaimOptions,
+) ([]Result[string], error) {
+	// Copy rather than mutate the caller-supplied options: SetJustId() sets isJustId in place, and the caller
+	// may reuse claimOptions for an unrelated, non-JUSTID XClaimWithOptions call afterwards.
+	justIdOptions := *claimOptions
+	result, err := client.XClaimWithOptionsResult(key, group, consumer, minIdleTime, ids, justIdOptions.SetJustId())
+	if err != nil {
+		return nil, err
+	}
+	return result.Ids(), nil
+}
@@ -0,0 +1,271 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// StreamEntry represents a single entry returned by `XREAD`/`XREADGROUP`, and nested inside the responses of
+// `XINFO STREAM` and `XINFO STREAM FULL`.
+type StreamEntry struct {
+	// ID is the stream entry ID.
+	ID string
+	// FieldValues holds the field-value pairs of the entry, in the order returned by the server.
+	FieldValues [][]string
+}
+
+// XClaimResult is a sum type wrapping the two possible shapes of an `XCLAIM` response: the full entries keyed by
+// ID when `JUSTID` was not requested, or a plain array of IDs when `options.StreamClaimOptions.SetJustId` was used.
+// Exactly one of the two accessors is populated, depending on [XClaimResult.IsJustId].
+type XClaimResult struct {
+	entries map[Result[string]][][]Result[string]
+	ids     []Result[string]
+	justId  bool
+}
+
+// IsJustId reports whether this result came from a `JUSTID` claim, in which case [XClaimResult.Ids] is populated
+// and [XClaimResult.Entries] is not.
+func (xcr XClaimResult) IsJustId() bool {
+	return xcr.justId
+}
+
+// Entries returns the claimed entries, keyed by ID. Only populated when `!IsJustId()`.
+func (xcr XClaimResult) Entries() map[Result[string]][][]Result[string] {
+	return xcr.entries
+}
+
+// Ids returns the claimed entry IDs. Only populated when `IsJustId()`.
+func (xcr XClaimResult) Ids() []Result[string] {
+	return xcr.ids
+}
+
+// NewXClaimEntriesResult wraps the full-entries form of an `XCLAIM` response.
+func NewXClaimEntriesResult(entries map[Result[string]][][]Result[string]) XClaimResult {
+	return XClaimResult{entries: entries}
+}
+
+// NewXClaimJustIdResult wraps the `JUSTID` form of an `XCLAIM` response.
+func NewXClaimJustIdResult(ids []Result[string]) XClaimResult {
+	return XClaimResult{ids: ids, justId: true}
+}
+
+// XAutoClaimResult is a sum type wrapping the two possible shapes of the claimed-entries portion of an
+// `XAUTOCLAIM` response: the full entries keyed by ID when `JUSTID` was not requested, or a plain array of IDs
+// when `options.XAutoClaimOptions.SetJustId` was used. Exactly one of the two accessors is populated, depending
+// on [XAutoClaimResult.IsJustId].
+type XAutoClaimResult struct {
+	entries map[Result[string]][][]Result[string]
+	ids     []Result[string]
+	justId  bool
+}
+
+// IsJustId reports whether this result came from a `JUSTID` claim, in which case [XAutoClaimResult.Ids] is
+// populated and [XAutoClaimResult.Entries] is not.
+func (xacr XAutoClaimResult) IsJustId() bool {
+	return xacr.justId
+}
+
+// Entries returns the claimed entries, keyed by ID. Only populated when `!IsJustId()`.
+func (xacr XAutoClaimResult) Entries() map[Result[string]][][]Result[string] {
+	return xacr.entries
+}
+
+// Ids returns the claimed entry IDs. Only populated when `IsJustId()`.
+func (xacr XAutoClaimResult) Ids() []Result[string] {
+	return xacr.ids
+}
+
+// NewXAutoClaimEntriesResult wraps the full-entries form of an `XAUTOCLAIM` response.
+func NewXAutoClaimEntriesResult(entries map[Result[string]][][]Result[string]) XAutoClaimResult {
+	return XAutoClaimResult{entries: entries}
+}
+
+// NewXAutoClaimJustIdResult wraps the `JUSTID` form of an `XAUTOCLAIM` response.
+func NewXAutoClaimJustIdResult(ids []Result[string]) XAutoClaimResult {
+	return XAutoClaimResult{ids: ids, justId: true}
+}
+
+// StreamInfo represents the response of `XINFO STREAM key`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-stream/
+type StreamInfo struct {
+	// Length is the number of entries in the stream.
+	Length int64
+	// RadixTreeKeys is the number of keys in the underlying radix data structure.
+	RadixTreeKeys int64
+	// RadixTreeNodes is the number of nodes in the underlying radix data structure.
+	RadixTreeNodes int64
+	// LastGeneratedId is the ID of the latest entry that was added to the stream.
+	LastGeneratedId string
+	// MaxDeletedEntryId is the maximum entry ID that was deleted from the stream.
+	MaxDeletedEntryId Result[string]
+	// EntriesAdded is the count of all entries added to the stream during its lifetime.
+	EntriesAdded Result[int64]
+	// RecordedFirstEntryId is the ID of the first entry that had been added to the stream.
+	RecordedFirstEntryId string
+	// GroupsCount is the number of consumer groups defined for the stream.
+	GroupsCount int64
+	// FirstEntry is the first entry in the stream.
+	FirstEntry StreamEntry
+	// LastEntry is the last entry in the stream.
+	LastEntry StreamEntry
+}
+
+// StreamGroupInfo represents a single consumer group entry of the response of `XINFO GROUPS key`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-groups/
+type StreamGroupInfo struct {
+	// Name is the consumer group's name.
+	Name string
+	// Consumers is the number of consumers in the group.
+	Consumers int64
+	// Pending is the length of the group's pending entries list (PEL).
+	Pending int64
+	// LastDeliveredId is the ID of the last entry delivered to the group's consumers.
+	LastDeliveredId string
+	// EntriesRead is the logical "read counter" of the last entry delivered to the group's consumers.
+	EntriesRead Result[int64]
+	// Lag is the number of entries in the stream that are still waiting to be delivered to the group's consumers.
+	Lag Result[int64]
+}
+
+// StreamConsumerInfo represents a single consumer entry of the response of `XINFO CONSUMERS key group`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-consumers/
+type StreamConsumerInfo struct {
+	// Name is the consumer's name.
+	Name string
+	// Pending is the number of pending messages for the consumer, which are messages that were delivered but are
+	// yet to be acknowledged.
+	Pending int64
+	// Idle is the number of milliseconds since the consumer's last attempted interaction.
+	Idle int64
+	// Inactive is the number of milliseconds since the consumer's last successful interaction.
+	Inactive Result[int64]
+}
+
+// StreamFullPelEntry represents a single entry in a group's pending entries list reported by
+// `XINFO STREAM key FULL`.
+type StreamFullPelEntry struct {
+	// ID is the stream entry ID.
+	ID string
+	// ConsumerName is the name of the consumer that owns the entry.
+	ConsumerName string
+	// DeliveryTime is the delivery time of the entry, as a unix timestamp in milliseconds.
+	DeliveryTime int64
+	// DeliveryCount is the number of times the entry has been delivered.
+	DeliveryCount int64
+}
+
+// StreamFullConsumerPelEntry represents a single entry in a consumer's own pending entries list reported by
+// `XINFO STREAM key FULL`. Unlike [StreamFullPelEntry], it carries no consumer name, since the owning consumer
+// is implied.
+type StreamFullConsumerPelEntry struct {
+	// ID is the stream entry ID.
+	ID string
+	// DeliveryTime is the delivery time of the entry, as a unix timestamp in milliseconds.
+	DeliveryTime int64
+	// DeliveryCount is the number of times the entry has been delivered.
+	DeliveryCount int64
+}
+
+// StreamFullConsumerInfo represents a single consumer entry reported by `XINFO STREAM key FULL`.
+type StreamFullConsumerInfo struct {
+	// Name is the consumer's name.
+	Name string
+	// SeenTime is the unix timestamp in milliseconds of the consumer's last attempted interaction.
+	SeenTime int64
+	// ActiveTime is the unix timestamp in milliseconds of the consumer's last successful interaction.
+	ActiveTime Result[int64]
+	// PelCount is the length of the consumer's own pending entries list.
+	PelCount int64
+	// Pending is the consumer's own pending entries list.
+	Pending []StreamFullConsumerPelEntry
+}
+
+// StreamFullGroupInfo represents a single consumer group entry reported by `XINFO STREAM key FULL`.
+type StreamFullGroupInfo struct {
+	// Name is the consumer group's name.
+	Name string
+	// LastDeliveredId is the ID of the last entry delivered to the group's consumers.
+	LastDeliveredId string
+	// PelCount is the length of the group's pending entries list (PEL).
+	PelCount int64
+	// Pending is the group's pending entries list.
+	Pending []StreamFullPelEntry
+	// Consumers is the group's consumers.
+	Consumers []StreamFullConsumerInfo
+	// EntriesRead is the logical "read counter" of the last entry delivered to the group's consumers.
+	EntriesRead Result[int64]
+	// Lag is the number of entries in the stream that are still waiting to be delivered to the group's consumers.
+	Lag Result[int64]
+}
+
+// StreamPendingSummary represents the response of `XPENDING key group`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/xpending/
+type StreamPendingSummary struct {
+	// NumOfMessages is the total number of pending messages for the consumer group.
+	NumOfMessages int64
+	// StartId is the smallest ID among the pending messages, or `nil` if there are none.
+	StartId Result[string]
+	// EndId is the greatest ID among the pending messages, or `nil` if there are none.
+	EndId Result[string]
+	// ConsumerMessages maps each consumer that owns pending messages to the number of messages it owns.
+	ConsumerMessages []ConsumerPendingMessages
+}
+
+// ConsumerPendingMessages represents a single consumer's entry in a [StreamPendingSummary].
+type ConsumerPendingMessages struct {
+	// ConsumerName is the name of the consumer.
+	ConsumerName string
+	// MessageCount is the number of pending messages owned by the consumer.
+	MessageCount int64
+}
+
+// StreamPendingDetail represents a single entry of the response of `XPENDING key group [[IDLE ms] start end count]`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/xpending/
+type StreamPendingDetail struct {
+	// Id is the ID of the pending message.
+	Id string
+	// ConsumerName is the name of the consumer that owns the message.
+	ConsumerName string
+	// IdleTime is the number of milliseconds since the message was last delivered.
+	IdleTime int64
+	// DeliveryCount is the number of times the message has been delivered.
+	DeliveryCount int64
+}
+
+// StreamFullInfo represents the response of `XINFO STREAM key FULL`.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/xinfo-stream/
+type StreamFullInfo struct {
+	// Length is the number of entries in the stream.
+	Length int64
+	// RadixTreeKeys is the number of keys in the underlying radix data structure.
+	RadixTreeKeys int64
+	// RadixTreeNodes is the number of nodes in the underlying radix data structure.
+	RadixTreeNodes int64
+	// LastGeneratedId is the ID of the latest entry that was added to the stream.
+	LastGeneratedId string
+	// MaxDeletedEntryId is the maximum entry ID that was deleted from the stream.
+	MaxDeletedEntryId Result[string]
+	// EntriesAdded is the count of all entries added to the stream during its lifetime.
+	EntriesAdded Result[int64]
+	// RecordedFirstEntryId is the ID of the first entry that had been added to the stream.
+	RecordedFirstEntryId string
+	// Entries is the list of entries reported, bounded by the `FULL [COUNT n]` modifier.
+	Entries []StreamEntry
+	// Groups is the stream's consumer groups.
+	Groups []StreamFullGroupInfo
+}
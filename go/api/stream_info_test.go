@@ -0,0 +1,61 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestXClaimResultShapeSelection checks that each XClaimResult constructor reports IsJustId() accordingly and
+// populates only the matching accessor, the two shapes XClaimWithOptionsResult picks between.
+func TestXClaimResultShapeSelection(t *testing.T) {
+	entries := map[Result[string]][][]Result[string]{
+		CreateStringResult("1-1"): {{CreateStringResult("field1"), CreateStringResult("value1")}},
+	}
+	entriesResult := NewXClaimEntriesResult(entries)
+	if entriesResult.IsJustId() {
+		t.Fatalf("entries result should not report IsJustId()")
+	}
+	if !reflect.DeepEqual(entriesResult.Entries(), entries) {
+		t.Fatalf("Entries() = %+v, want %+v", entriesResult.Entries(), entries)
+	}
+	if entriesResult.Ids() != nil {
+		t.Fatalf("Ids() = %+v, want nil for an entries result", entriesResult.Ids())
+	}
+
+	ids := []Result[string]{CreateStringResult("1-1")}
+	justIdResult := NewXClaimJustIdResult(ids)
+	if !justIdResult.IsJustId() {
+		t.Fatalf("justid result should report IsJustId()")
+	}
+	if !reflect.DeepEqual(justIdResult.Ids(), ids) {
+		t.Fatalf("Ids() = %+v, want %+v", justIdResult.Ids(), ids)
+	}
+	if justIdResult.Entries() != nil {
+		t.Fatalf("Entries() = %+v, want nil for a justid result", justIdResult.Entries())
+	}
+}
+
+// TestXAutoClaimResultShapeSelection is the XAutoClaim analogue of TestXClaimResultShapeSelection.
+func TestXAutoClaimResultShapeSelection(t *testing.T) {
+	entries := map[Result[string]][][]Result[string]{
+		CreateStringResult("1-1"): {{CreateStringResult("field1"), CreateStringResult("value1")}},
+	}
+	entriesResult := NewXAutoClaimEntriesResult(entries)
+	if entriesResult.IsJustId() {
+		t.Fatalf("entries result should not report IsJustId()")
+	}
+	if !reflect.DeepEqual(entriesResult.Entries(), entries) {
+		t.Fatalf("Entries() = %+v, want %+v", entriesResult.Entries(), entries)
+	}
+
+	ids := []Result[string]{CreateStringResult("1-1")}
+	justIdResult := NewXAutoClaimJustIdResult(ids)
+	if !justIdResult.IsJustId() {
+		t.Fatalf("justid result should report IsJustId()")
+	}
+	if !reflect.DeepEqual(justIdResult.Ids(), ids) {
+		t.Fatalf("Ids() = %+v, want %+v", justIdResult.Ids(), ids)
+	}
+}
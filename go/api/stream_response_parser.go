@@ -0,0 +1,328 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import "strconv"
+
+// This file converts the generic, already-deserialized replies produced by [handleAnyResponse] into the typed
+// stream response structs declared in stream_info.go. Keeping this logic independent of the FFI response type
+// makes it straightforward to unit test against the documented Valkey reply shapes.
+//
+// See [valkey.io] for the reply shape of each command.
+//
+// [valkey.io]: https://valkey.io/commands/#stream
+
+func asAnyArray(raw interface{}) []interface{} {
+	if raw == nil {
+		return nil
+	}
+	array, _ := raw.([]interface{})
+	return array
+}
+
+func asStringMap(raw interface{}) map[string]interface{} {
+	if raw == nil {
+		return nil
+	}
+	if m, ok := raw.(map[string]interface{}); ok {
+		return m
+	}
+	// RESP2 connections report maps as a flat [field, value, field, value, ...] array.
+	array := asAnyArray(raw)
+	m := make(map[string]interface{}, len(array)/2)
+	for i := 0; i+1 < len(array); i += 2 {
+		if key, ok := array[i].(string); ok {
+			m[key] = array[i+1]
+		}
+	}
+	return m
+}
+
+func resultString(raw interface{}) Result[string] {
+	if raw == nil {
+		return CreateNilStringResult()
+	}
+	if s, ok := raw.(string); ok {
+		return CreateStringResult(s)
+	}
+	return CreateNilStringResult()
+}
+
+func resultInt64(raw interface{}) Result[int64] {
+	if raw == nil {
+		return CreateNilInt64Result()
+	}
+	if i, ok := raw.(int64); ok {
+		return CreateInt64Result(i)
+	}
+	return CreateNilInt64Result()
+}
+
+func int64Value(raw interface{}) int64 {
+	i, _ := raw.(int64)
+	return i
+}
+
+func stringValue(raw interface{}) string {
+	s, _ := raw.(string)
+	return s
+}
+
+// parseStreamEntries converts the `[[id, [field, value, ...]], ...]` shape of the entries section of
+// `XINFO STREAM FULL` and of each stream in `XREAD`/`XREADGROUP`. The single `[id, [field, value, ...]]` first/last
+// entry of `XINFO STREAM` is parsed by [parseSingleStreamEntry].
+func parseStreamEntries(raw []interface{}) []StreamEntry {
+	entries := make([]StreamEntry, 0, len(raw))
+	for _, rawEntry := range raw {
+		pair := asAnyArray(rawEntry)
+		if len(pair) != 2 {
+			continue
+		}
+		entries = append(entries, StreamEntry{
+			ID:          stringValue(pair[0]),
+			FieldValues: parseFieldValuePairs(asAnyArray(pair[1])),
+		})
+	}
+	return entries
+}
+
+func parseFieldValuePairs(raw []interface{}) [][]string {
+	pairs := make([][]string, 0, len(raw)/2)
+	for i := 0; i+1 < len(raw); i += 2 {
+		pairs = append(pairs, []string{stringValue(raw[i]), stringValue(raw[i+1])})
+	}
+	return pairs
+}
+
+// parseClaimedEntries converts the `[[entryId, [[field, value], ...]], ...]` array returned by `XCLAIM`/`XAUTOCLAIM`
+// when `JUSTID` was not requested into a map keyed by entry ID. Items that are not `[entryId, fieldValues]` pairs
+// are skipped.
+func parseClaimedEntries(raw interface{}) map[Result[string]][][]Result[string] {
+	entries := make(map[Result[string]][][]Result[string])
+	for _, rawEntry := range asAnyArray(raw) {
+		pair := asAnyArray(rawEntry)
+		if len(pair) != 2 {
+			continue
+		}
+		id := resultString(pair[0])
+		fieldValues := make([][]Result[string], 0)
+		for _, rawFieldValue := range asAnyArray(pair[1]) {
+			fv := asAnyArray(rawFieldValue)
+			row := make([]Result[string], 0, len(fv))
+			for _, v := range fv {
+				row = append(row, resultString(v))
+			}
+			fieldValues = append(fieldValues, row)
+		}
+		entries[id] = fieldValues
+	}
+	return entries
+}
+
+func parseStringResultArray(raw interface{}) []Result[string] {
+	array := asAnyArray(raw)
+	results := make([]Result[string], 0, len(array))
+	for _, v := range array {
+		results = append(results, resultString(v))
+	}
+	return results
+}
+
+// parseXAutoClaimResponse splits the 3-element `[next-cursor, entries, deleted-ids]` reply shared by
+// `XAUTOCLAIM`/`XAUTOCLAIM ... JUSTID`. The caller is responsible for interpreting `entries` according to whether
+// `JUSTID` was requested.
+func parseXAutoClaimResponse(raw interface{}) (nextCursor Result[string], entries interface{}, deletedIds []Result[string]) {
+	array := asAnyArray(raw)
+	if len(array) != 3 {
+		return CreateNilStringResult(), nil, nil
+	}
+	return resultString(array[0]), array[1], parseStringResultArray(array[2])
+}
+
+func parseStreamInfo(raw map[string]interface{}) StreamInfo {
+	return StreamInfo{
+		Length:               int64Value(raw["length"]),
+		RadixTreeKeys:        int64Value(raw["radix-tree-keys"]),
+		RadixTreeNodes:       int64Value(raw["radix-tree-nodes"]),
+		LastGeneratedId:      stringValue(raw["last-generated-id"]),
+		MaxDeletedEntryId:    resultString(raw["max-deleted-entry-id"]),
+		EntriesAdded:         resultInt64(raw["entries-added"]),
+		RecordedFirstEntryId: stringValue(raw["recorded-first-entry-id"]),
+		GroupsCount:          int64Value(raw["groups"]),
+		FirstEntry:           parseSingleStreamEntry(raw["first-entry"]),
+		LastEntry:            parseSingleStreamEntry(raw["last-entry"]),
+	}
+}
+
+func parseSingleStreamEntry(raw interface{}) StreamEntry {
+	pair := asAnyArray(raw)
+	if len(pair) != 2 {
+		return StreamEntry{}
+	}
+	return StreamEntry{ID: stringValue(pair[0]), FieldValues: parseFieldValuePairs(asAnyArray(pair[1]))}
+}
+
+func parseStreamFullInfo(raw map[string]interface{}) StreamFullInfo {
+	groups := make([]StreamFullGroupInfo, 0)
+	for _, rawGroup := range asAnyArray(raw["groups"]) {
+		groups = append(groups, parseStreamFullGroupInfo(asStringMap(rawGroup)))
+	}
+	return StreamFullInfo{
+		Length:               int64Value(raw["length"]),
+		RadixTreeKeys:        int64Value(raw["radix-tree-keys"]),
+		RadixTreeNodes:       int64Value(raw["radix-tree-nodes"]),
+		LastGeneratedId:      stringValue(raw["last-generated-id"]),
+		MaxDeletedEntryId:    resultString(raw["max-deleted-entry-id"]),
+		EntriesAdded:         resultInt64(raw["entries-added"]),
+		RecordedFirstEntryId: stringValue(raw["recorded-first-entry-id"]),
+		Entries:              parseStreamEntries(asAnyArray(raw["entries"])),
+		Groups:               groups,
+	}
+}
+
+func parseStreamFullGroupInfo(raw map[string]interface{}) StreamFullGroupInfo {
+	consumers := make([]StreamFullConsumerInfo, 0)
+	for _, rawConsumer := range asAnyArray(raw["consumers"]) {
+		consumers = append(consumers, parseStreamFullConsumerInfo(asStringMap(rawConsumer)))
+	}
+	return StreamFullGroupInfo{
+		Name:            stringValue(raw["name"]),
+		LastDeliveredId: stringValue(raw["last-delivered-id"]),
+		PelCount:        int64Value(raw["pel-count"]),
+		Pending:         parseStreamFullPelEntries(asAnyArray(raw["pending"])),
+		Consumers:       consumers,
+		EntriesRead:     resultInt64(raw["entries-read"]),
+		Lag:             resultInt64(raw["lag"]),
+	}
+}
+
+func parseStreamFullConsumerInfo(raw map[string]interface{}) StreamFullConsumerInfo {
+	return StreamFullConsumerInfo{
+		Name:       stringValue(raw["name"]),
+		SeenTime:   int64Value(raw["seen-time"]),
+		ActiveTime: resultInt64(raw["active-time"]),
+		PelCount:   int64Value(raw["pel-count"]),
+		Pending:    parseStreamFullConsumerPelEntries(asAnyArray(raw["pending"])),
+	}
+}
+
+func parseStreamFullPelEntries(raw []interface{}) []StreamFullPelEntry {
+	entries := make([]StreamFullPelEntry, 0, len(raw))
+	for _, rawEntry := range raw {
+		fields := asAnyArray(rawEntry)
+		if len(fields) != 4 {
+			continue
+		}
+		entries = append(entries, StreamFullPelEntry{
+			ID:            stringValue(fields[0]),
+			ConsumerName:  stringValue(fields[1]),
+			DeliveryTime:  int64Value(fields[2]),
+			DeliveryCount: int64Value(fields[3]),
+		})
+	}
+	return entries
+}
+
+// parseStreamFullConsumerPelEntries parses a consumer's own pending entries list, as reported nested under
+// `consumers` by `XINFO STREAM key FULL`. Unlike a group's top-level `pending` list (see
+// [parseStreamFullPelEntries]), each entry here is a 3-tuple of (id, delivery-time, delivery-count) — the
+// consumer name is omitted because it's implied by the enclosing consumer object.
+func parseStreamFullConsumerPelEntries(raw []interface{}) []StreamFullConsumerPelEntry {
+	entries := make([]StreamFullConsumerPelEntry, 0, len(raw))
+	for _, rawEntry := range raw {
+		fields := asAnyArray(rawEntry)
+		if len(fields) != 3 {
+			continue
+		}
+		entries = append(entries, StreamFullConsumerPelEntry{
+			ID:            stringValue(fields[0]),
+			DeliveryTime:  int64Value(fields[1]),
+			DeliveryCount: int64Value(fields[2]),
+		})
+	}
+	return entries
+}
+
+func parseStreamGroupInfos(raw []interface{}) []StreamGroupInfo {
+	groups := make([]StreamGroupInfo, 0, len(raw))
+	for _, rawGroup := range raw {
+		group := asStringMap(rawGroup)
+		groups = append(groups, StreamGroupInfo{
+			Name:            stringValue(group["name"]),
+			Consumers:       int64Value(group["consumers"]),
+			Pending:         int64Value(group["pending"]),
+			LastDeliveredId: stringValue(group["last-delivered-id"]),
+			EntriesRead:     resultInt64(group["entries-read"]),
+			Lag:             resultInt64(group["lag"]),
+		})
+	}
+	return groups
+}
+
+func parseStreamConsumerInfos(raw []interface{}) []StreamConsumerInfo {
+	consumers := make([]StreamConsumerInfo, 0, len(raw))
+	for _, rawConsumer := range raw {
+		consumer := asStringMap(rawConsumer)
+		consumers = append(consumers, StreamConsumerInfo{
+			Name:     stringValue(consumer["name"]),
+			Pending:  int64Value(consumer["pending"]),
+			Idle:     int64Value(consumer["idle"]),
+			Inactive: resultInt64(consumer["inactive"]),
+		})
+	}
+	return consumers
+}
+
+// parseStreamPendingSummary converts the `[count, minId, maxId, [[consumer, count], ...]]` reply of
+// `XPENDING key group`.
+func parseStreamPendingSummary(raw []interface{}) StreamPendingSummary {
+	if len(raw) != 4 {
+		return StreamPendingSummary{}
+	}
+	consumerMessages := make([]ConsumerPendingMessages, 0)
+	for _, rawConsumer := range asAnyArray(raw[3]) {
+		pair := asAnyArray(rawConsumer)
+		if len(pair) != 2 {
+			continue
+		}
+		count, _ := strconv.ParseInt(stringValue(pair[1]), 10, 64)
+		consumerMessages = append(consumerMessages, ConsumerPendingMessages{
+			ConsumerName: stringValue(pair[0]),
+			MessageCount: count,
+		})
+	}
+	return StreamPendingSummary{
+		NumOfMessages:    int64Value(raw[0]),
+		StartId:          resultString(raw[1]),
+		EndId:            resultString(raw[2]),
+		ConsumerMessages: consumerMessages,
+	}
+}
+
+// parseStreamPendingDetails converts the `[[id, consumer, idle, deliveryCount], ...]` reply of the detailed
+// `XPENDING key group [[IDLE ms] start end count]` form.
+func parseStreamPendingDetails(raw []interface{}) []StreamPendingDetail {
+	details := make([]StreamPendingDetail, 0, len(raw))
+	for _, rawDetail := range raw {
+		fields := asAnyArray(rawDetail)
+		if len(fields) != 4 {
+			continue
+		}
+		details = append(details, StreamPendingDetail{
+			Id:            stringValue(fields[0]),
+			ConsumerName:  stringValue(fields[1]),
+			IdleTime:      int64Value(fields[2]),
+			DeliveryCount: int64Value(fields[3]),
+		})
+	}
+	return details
+}
+
+// parseStreamReadResponse converts the per-stream map reply of `XREAD`/`XREADGROUP` into `map[string][]StreamEntry`.
+func parseStreamReadResponse(raw interface{}) map[string][]StreamEntry {
+	result := make(map[string][]StreamEntry)
+	for key, rawEntries := range asStringMap(raw) {
+		result[key] = parseStreamEntries(asAnyArray(rawEntries))
+	}
+	return result
+}
@@ -0,0 +1,250 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStreamEntries(t *testing.T) {
+	raw := []interface{}{
+		[]interface{}{"1-1", []interface{}{"field1", "value1"}},
+	}
+	entries := parseStreamEntries(raw)
+	if len(entries) != 1 || entries[0].ID != "1-1" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	if !reflect.DeepEqual(entries[0].FieldValues, [][]string{{"field1", "value1"}}) {
+		t.Fatalf("unexpected field values: %+v", entries[0].FieldValues)
+	}
+}
+
+// TestAsStringMapResp2FlatArrayFallback exercises the RESP2 flat-array shape of asStringMap, used when the
+// connection is not reporting maps natively (see the split-out, independently testable parsing layer that
+// stream_response_parser.go documents at the top).
+func TestAsStringMapResp2FlatArrayFallback(t *testing.T) {
+	raw := []interface{}{"name", "consumer-1", "pending", int64(1)}
+	m := asStringMap(raw)
+	want := map[string]interface{}{"name": "consumer-1", "pending": int64(1)}
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("asStringMap(%+v) = %+v, want %+v", raw, m, want)
+	}
+}
+
+func TestParseStreamInfo(t *testing.T) {
+	raw := map[string]interface{}{
+		"length":                  int64(2),
+		"radix-tree-keys":         int64(1),
+		"radix-tree-nodes":        int64(2),
+		"last-generated-id":       "2-1",
+		"max-deleted-entry-id":    "0-0",
+		"entries-added":           int64(2),
+		"recorded-first-entry-id": "1-1",
+		"groups":                  int64(1),
+		"first-entry":             []interface{}{"1-1", []interface{}{"field1", "value1"}},
+		"last-entry":              []interface{}{"2-1", []interface{}{"field2", "value2"}},
+	}
+	info := parseStreamInfo(raw)
+	want := StreamInfo{
+		Length:               2,
+		RadixTreeKeys:        1,
+		RadixTreeNodes:       2,
+		LastGeneratedId:      "2-1",
+		MaxDeletedEntryId:    CreateStringResult("0-0"),
+		EntriesAdded:         CreateInt64Result(2),
+		RecordedFirstEntryId: "1-1",
+		GroupsCount:          1,
+		FirstEntry:           StreamEntry{ID: "1-1", FieldValues: [][]string{{"field1", "value1"}}},
+		LastEntry:            StreamEntry{ID: "2-1", FieldValues: [][]string{{"field2", "value2"}}},
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Fatalf("parseStreamInfo() = %+v, want %+v", info, want)
+	}
+}
+
+func TestParseStreamGroupInfos(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"name":              "group1",
+			"consumers":         int64(2),
+			"pending":           int64(1),
+			"last-delivered-id": "1-1",
+			"entries-read":      int64(1),
+			"lag":               int64(0),
+		},
+	}
+	groups := parseStreamGroupInfos(raw)
+	want := []StreamGroupInfo{
+		{
+			Name:            "group1",
+			Consumers:       2,
+			Pending:         1,
+			LastDeliveredId: "1-1",
+			EntriesRead:     CreateInt64Result(1),
+			Lag:             CreateInt64Result(0),
+		},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Fatalf("parseStreamGroupInfos() = %+v, want %+v", groups, want)
+	}
+}
+
+func TestParseStreamConsumerInfos(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"name":     "consumer1",
+			"pending":  int64(1),
+			"idle":     int64(100),
+			"inactive": int64(200),
+		},
+	}
+	consumers := parseStreamConsumerInfos(raw)
+	want := []StreamConsumerInfo{
+		{Name: "consumer1", Pending: 1, Idle: 100, Inactive: CreateInt64Result(200)},
+	}
+	if !reflect.DeepEqual(consumers, want) {
+		t.Fatalf("parseStreamConsumerInfos() = %+v, want %+v", consumers, want)
+	}
+}
+
+func TestParseStreamFullConsumerInfoPending(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":      "consumer-1",
+		"seen-time": int64(100),
+		"pel-count": int64(1),
+		"pending": []interface{}{
+			// A consumer's own pending entries are a 3-tuple (id, delivery-time, delivery-count):
+			// the consumer name is omitted because it's implied by the enclosing consumer object.
+			[]interface{}{"1-1", int64(100), int64(1)},
+		},
+	}
+	info := parseStreamFullConsumerInfo(raw)
+	if info.PelCount != 1 {
+		t.Fatalf("unexpected pel count: %v", info.PelCount)
+	}
+	want := []StreamFullConsumerPelEntry{{ID: "1-1", DeliveryTime: 100, DeliveryCount: 1}}
+	if !reflect.DeepEqual(info.Pending, want) {
+		t.Fatalf("unexpected pending entries: %+v", info.Pending)
+	}
+}
+
+func TestParseXAutoClaimResponse(t *testing.T) {
+	raw := []interface{}{
+		"0-0",
+		[]interface{}{[]interface{}{"1-1", []interface{}{"f", "v"}}},
+		[]interface{}{"2-2"},
+	}
+	nextCursor, entries, deleted := parseXAutoClaimResponse(raw)
+	if nextCursor.Value() != "0-0" {
+		t.Fatalf("unexpected cursor: %v", nextCursor.Value())
+	}
+	claimed := parseClaimedEntries(entries)
+	if len(claimed) != 1 {
+		t.Fatalf("unexpected claimed entries: %+v", claimed)
+	}
+	if len(deleted) != 1 || deleted[0].Value() != "2-2" {
+		t.Fatalf("unexpected deleted ids: %+v", deleted)
+	}
+}
+
+func TestParseStreamPendingSummary(t *testing.T) {
+	raw := []interface{}{
+		int64(2),
+		"1-1",
+		"2-2",
+		[]interface{}{[]interface{}{"consumer1", "2"}},
+	}
+	summary := parseStreamPendingSummary(raw)
+	if summary.NumOfMessages != 2 || summary.StartId.Value() != "1-1" || summary.EndId.Value() != "2-2" {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+	if len(summary.ConsumerMessages) != 1 || summary.ConsumerMessages[0].MessageCount != 2 {
+		t.Fatalf("unexpected consumer messages: %+v", summary.ConsumerMessages)
+	}
+}
+
+func TestParseStreamPendingDetails(t *testing.T) {
+	raw := []interface{}{
+		[]interface{}{"1-1", "consumer1", int64(100), int64(1)},
+		[]interface{}{"2-2", "consumer2", int64(200), int64(3)},
+	}
+	details := parseStreamPendingDetails(raw)
+	want := []StreamPendingDetail{
+		{Id: "1-1", ConsumerName: "consumer1", IdleTime: 100, DeliveryCount: 1},
+		{Id: "2-2", ConsumerName: "consumer2", IdleTime: 200, DeliveryCount: 3},
+	}
+	if !reflect.DeepEqual(details, want) {
+		t.Fatalf("unexpected details: %+v, want %+v", details, want)
+	}
+}
+
+func TestParseStreamReadResponse(t *testing.T) {
+	raw := map[string]interface{}{
+		"myStream": []interface{}{
+			[]interface{}{"1-1", []interface{}{"field1", "value1"}},
+		},
+	}
+	result := parseStreamReadResponse(raw)
+	if len(result["myStream"]) != 1 || result["myStream"][0].ID != "1-1" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+// TestParseXAutoClaimResponsePagination drives parseXAutoClaimResponse/parseClaimedEntries across a
+// sequence of hand-built raw replies to check that the parsed cursor from one page can be fed back in
+// to reach the next, the way a caller would page through a large Pending Entries List until the server
+// reports "0-0". It exercises only the response parsing, not the `XAutoClaim`/`XAutoClaimWithOptions`
+// command wiring.
+func TestParseXAutoClaimResponsePagination(t *testing.T) {
+	pages := map[string][]interface{}{
+		"0-0": {
+			"100-1",
+			[]interface{}{[]interface{}{"1-1", []interface{}{"field1", "value1"}}},
+			[]interface{}{},
+		},
+		"100-1": {
+			"0-0",
+			[]interface{}{[]interface{}{"2-1", []interface{}{"field2", "value2"}}},
+			[]interface{}{"3-1"},
+		},
+	}
+
+	var claimedIds []string
+	var deletedIds []string
+	cursor := "0-0"
+	pageCount := 0
+
+	for {
+		pageCount++
+		if pageCount > len(pages)+1 {
+			t.Fatalf("pagination did not terminate")
+		}
+
+		page, ok := pages[cursor]
+		if !ok {
+			t.Fatalf("no page registered for cursor %q", cursor)
+		}
+
+		nextCursor, rawEntries, pageDeletedIds := parseXAutoClaimResponse(page)
+		for id := range parseClaimedEntries(rawEntries) {
+			claimedIds = append(claimedIds, id.Value())
+		}
+		for _, id := range pageDeletedIds {
+			deletedIds = append(deletedIds, id.Value())
+		}
+
+		if nextCursor.Value() == "0-0" {
+			break
+		}
+		cursor = nextCursor.Value()
+	}
+
+	if pageCount != 2 {
+		t.Fatalf("expected 2 pages, got %d", pageCount)
+	}
+	if len(claimedIds) != 2 {
+		t.Fatalf("expected 2 claimed entries across pages, got %v", claimedIds)
+	}
+	if len(deletedIds) != 1 || deletedIds[0] != "3-1" {
+		t.Fatalf("expected deleted id 3-1 on second page, got %v", deletedIds)
+	}
+}